@@ -0,0 +1,47 @@
+package datarepo
+
+// Delimiter names a field separator a CSVFilesFormatConfig can be configured with.
+type Delimiter string
+
+const (
+	DelimiterComma Delimiter = "comma"
+	DelimiterTab   Delimiter = "tab"
+	DelimiterPipe  Delimiter = "pipe"
+)
+
+// DelimiterMap resolves a Delimiter to the rune csv.Reader expects in its Comma field.
+var DelimiterMap = map[Delimiter]rune{
+	DelimiterComma: ',',
+	DelimiterTab:   '\t',
+	DelimiterPipe:  '|',
+}
+
+// CompressionFormatConfig names the codec a manifest declares its files are encoded with.
+type CompressionFormatConfig int
+
+const (
+	CompressionFormatConfigNone CompressionFormatConfig = iota
+	CompressionFormatConfigGzip
+	CompressionFormatConfigZstd
+	CompressionFormatConfigSnappy
+)
+
+// CSVFilesFormatConfig configures a dataset backed by a directory of CSV/TSV files.
+type CSVFilesFormatConfig struct {
+	Delimiter               Delimiter
+	Header                  bool
+	CompressionFormatConfig CompressionFormatConfig
+}
+
+func (c *CSVFilesFormatConfig) Kind() ManifestConfigKind { return DataformatIDCSVFiles }
+
+// ParquetFilesFormatConfig configures a dataset backed by a directory of Parquet files.
+type ParquetFilesFormatConfig struct{}
+
+func (c *ParquetFilesFormatConfig) Kind() ManifestConfigKind { return DataformatIDParquetFiles }
+
+// JSONLinesFilesFormatConfig configures a dataset backed by a directory of newline-delimited
+// JSON files.
+type JSONLinesFilesFormatConfig struct{}
+
+func (c *JSONLinesFilesFormatConfig) Kind() ManifestConfigKind { return DataformatIDJSONLinesFiles }
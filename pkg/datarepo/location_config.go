@@ -0,0 +1,40 @@
+package datarepo
+
+// AWSS3LocationConfig points at a prefix within an AWS S3 bucket.
+type AWSS3LocationConfig struct {
+	Bucket string
+	Prefix string
+}
+
+func (c *AWSS3LocationConfig) Kind() ManifestConfigKind { return DatasourceIDAWSS3 }
+
+// GCSLocationConfig points at a prefix within a Google Cloud Storage bucket.
+type GCSLocationConfig struct {
+	Bucket string
+	Prefix string
+}
+
+func (c *GCSLocationConfig) Kind() ManifestConfigKind { return DatasourceIDGCS }
+
+// AzureBlobLocationConfig points at a prefix within an Azure Blob Storage container.
+type AzureBlobLocationConfig struct {
+	Account   string
+	Container string
+	Prefix    string
+}
+
+func (c *AzureBlobLocationConfig) Kind() ManifestConfigKind { return DatasourceIDAzureBlob }
+
+// LocalFSLocationConfig points at a directory on the local filesystem.
+type LocalFSLocationConfig struct {
+	Path string
+}
+
+func (c *LocalFSLocationConfig) Kind() ManifestConfigKind { return DatasourceIDLocalFS }
+
+// HTTPLocationConfig points at a single object reachable over plain HTTP(S) range-GETs.
+type HTTPLocationConfig struct {
+	URL string
+}
+
+func (c *HTTPLocationConfig) Kind() ManifestConfigKind { return DatasourceIDHTTP }
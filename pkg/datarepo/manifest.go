@@ -0,0 +1,23 @@
+package datarepo
+
+// ManifestConfigKind identifies a datasource location or data format config's concrete type.
+type ManifestConfigKind string
+
+// ManifestConfig is implemented by every typed configuration block in a dataset manifest.
+type ManifestConfig interface {
+	Kind() ManifestConfigKind
+}
+
+const (
+	DatasourceIDAWSS3     ManifestConfigKind = "aws_s3"
+	DatasourceIDGCS       ManifestConfigKind = "gcs"
+	DatasourceIDAzureBlob ManifestConfigKind = "azure_blob"
+	DatasourceIDLocalFS   ManifestConfigKind = "local_fs"
+	DatasourceIDHTTP      ManifestConfigKind = "http"
+)
+
+const (
+	DataformatIDCSVFiles       ManifestConfigKind = "csv_files"
+	DataformatIDParquetFiles   ManifestConfigKind = "parquet_files"
+	DataformatIDJSONLinesFiles ManifestConfigKind = "jsonlines_files"
+)
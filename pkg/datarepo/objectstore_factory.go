@@ -0,0 +1,33 @@
+package datarepo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Eventual-Inc/Daft/pkg/objectstorage"
+)
+
+// ObjectStoreFactory constructs the objectstorage.ObjectStore backend addressed by
+// locationConfig. Adding a new datasource means adding a case here alongside the new
+// ManifestConfig/Kind pair in manifest.go and location_config.go.
+func ObjectStoreFactory(locationConfig ManifestConfig) (objectstorage.ObjectStore, error) {
+	ctx := context.Background()
+	switch locationConfig.Kind() {
+	case DatasourceIDAWSS3:
+		config := locationConfig.(*AWSS3LocationConfig)
+		return objectstorage.NewS3ObjectStore(ctx, config.Bucket)
+	case DatasourceIDGCS:
+		config := locationConfig.(*GCSLocationConfig)
+		return objectstorage.NewGCSObjectStore(ctx, config.Bucket)
+	case DatasourceIDAzureBlob:
+		config := locationConfig.(*AzureBlobLocationConfig)
+		return objectstorage.NewAzureBlobObjectStore(config.Account, config.Container)
+	case DatasourceIDLocalFS:
+		config := locationConfig.(*LocalFSLocationConfig)
+		return objectstorage.NewLocalFSObjectStore(config.Path), nil
+	case DatasourceIDHTTP:
+		return objectstorage.NewHTTPObjectStore(), nil
+	default:
+		return nil, fmt.Errorf("object store for %s not implemented", locationConfig.Kind())
+	}
+}
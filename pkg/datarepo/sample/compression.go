@@ -0,0 +1,85 @@
+package sample
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/Eventual-Inc/Daft/pkg/datarepo"
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
+)
+
+// CompressionFormat identifies the codec that a sampled file's bytes are encoded with.
+type CompressionFormat int
+
+const (
+	CompressionFormatNone CompressionFormat = iota
+	CompressionFormatGzip
+	CompressionFormatZstd
+	CompressionFormatSnappy
+)
+
+// compressionSuffixes maps a recognized file extension to its CompressionFormat.
+var compressionSuffixes = map[string]CompressionFormat{
+	".gz":     CompressionFormatGzip,
+	".zst":    CompressionFormatZstd,
+	".snappy": CompressionFormatSnappy,
+}
+
+// detectCompressionFormat sniffs objPath for a trailing compression extension (e.g.
+// "data.csv.gz"), returning the detected format and objPath with that extension stripped.
+func detectCompressionFormat(objPath string) (format CompressionFormat, trimmedPath string) {
+	for suffix, f := range compressionSuffixes {
+		if strings.HasSuffix(objPath, suffix) {
+			return f, strings.TrimSuffix(objPath, suffix)
+		}
+	}
+	return CompressionFormatNone, objPath
+}
+
+// resolveCompressionFormat determines which CompressionFormat applies to objPath: an explicit
+// override always wins over suffix sniffing. A recognized compression extension is stripped from
+// trimmedPath either way.
+func resolveCompressionFormat(objPath string, override datarepo.CompressionFormatConfig) (format CompressionFormat, trimmedPath string) {
+	if override != datarepo.CompressionFormatConfigNone {
+		_, trimmedPath = detectCompressionFormat(objPath)
+		return compressionFormatFromConfig(override), trimmedPath
+	}
+	return detectCompressionFormat(objPath)
+}
+
+func compressionFormatFromConfig(config datarepo.CompressionFormatConfig) CompressionFormat {
+	switch config {
+	case datarepo.CompressionFormatConfigGzip:
+		return CompressionFormatGzip
+	case datarepo.CompressionFormatConfigZstd:
+		return CompressionFormatZstd
+	case datarepo.CompressionFormatConfigSnappy:
+		return CompressionFormatSnappy
+	default:
+		return CompressionFormatNone
+	}
+}
+
+// wrapDecompressor wraps body with the decoder for format, or returns it unchanged for
+// CompressionFormatNone.
+func wrapDecompressor(body io.Reader, format CompressionFormat) (io.Reader, error) {
+	switch format {
+	case CompressionFormatNone:
+		return body, nil
+	case CompressionFormatGzip:
+		return gzip.NewReader(body)
+	case CompressionFormatZstd:
+		decoder, err := zstd.NewReader(body)
+		if err != nil {
+			return nil, err
+		}
+		return decoder.IOReadCloser(), nil
+	case CompressionFormatSnappy:
+		return snappy.NewReader(body), nil
+	default:
+		return nil, fmt.Errorf("unsupported compression format: %v", format)
+	}
+}
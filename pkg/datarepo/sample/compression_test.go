@@ -0,0 +1,113 @@
+package sample
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"testing"
+
+	"github.com/Eventual-Inc/Daft/pkg/datarepo"
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
+)
+
+func TestDetectCompressionFormat(t *testing.T) {
+	tests := []struct {
+		objPath         string
+		wantFormat      CompressionFormat
+		wantTrimmedPath string
+	}{
+		{"data.csv.gz", CompressionFormatGzip, "data.csv"},
+		{"data.csv.zst", CompressionFormatZstd, "data.csv"},
+		{"data.csv.snappy", CompressionFormatSnappy, "data.csv"},
+		{"data.csv", CompressionFormatNone, "data.csv"},
+	}
+	for _, tc := range tests {
+		format, trimmedPath := detectCompressionFormat(tc.objPath)
+		if format != tc.wantFormat || trimmedPath != tc.wantTrimmedPath {
+			t.Errorf("detectCompressionFormat(%q) = (%v, %q), want (%v, %q)",
+				tc.objPath, format, trimmedPath, tc.wantFormat, tc.wantTrimmedPath)
+		}
+	}
+}
+
+func TestResolveCompressionFormatOverrideWinsOverSuffix(t *testing.T) {
+	// The object key carries no recognizable suffix, so only the override can supply the format.
+	format, trimmedPath := resolveCompressionFormat("data.bin", datarepo.CompressionFormatConfigZstd)
+	if format != CompressionFormatZstd {
+		t.Errorf("format = %v, want %v", format, CompressionFormatZstd)
+	}
+	if trimmedPath != "data.bin" {
+		t.Errorf("trimmedPath = %q, want %q", trimmedPath, "data.bin")
+	}
+}
+
+func TestResolveCompressionFormatFallsBackToSniffing(t *testing.T) {
+	format, trimmedPath := resolveCompressionFormat("data.csv.gz", datarepo.CompressionFormatConfigNone)
+	if format != CompressionFormatGzip {
+		t.Errorf("format = %v, want %v", format, CompressionFormatGzip)
+	}
+	if trimmedPath != "data.csv" {
+		t.Errorf("trimmedPath = %q, want %q", trimmedPath, "data.csv")
+	}
+}
+
+func TestWrapDecompressorRoundTrips(t *testing.T) {
+	const want = "id,name\n1,a\n2,b\n"
+
+	gzipBuf := &bytes.Buffer{}
+	gzWriter := gzip.NewWriter(gzipBuf)
+	if _, err := gzWriter.Write([]byte(want)); err != nil {
+		t.Fatalf("gzip write: %v", err)
+	}
+	if err := gzWriter.Close(); err != nil {
+		t.Fatalf("gzip close: %v", err)
+	}
+
+	zstdBuf := &bytes.Buffer{}
+	zstdWriter, err := zstd.NewWriter(zstdBuf)
+	if err != nil {
+		t.Fatalf("zstd writer: %v", err)
+	}
+	if _, err := zstdWriter.Write([]byte(want)); err != nil {
+		t.Fatalf("zstd write: %v", err)
+	}
+	if err := zstdWriter.Close(); err != nil {
+		t.Fatalf("zstd close: %v", err)
+	}
+
+	snappyBuf := &bytes.Buffer{}
+	snappyWriter := snappy.NewBufferedWriter(snappyBuf)
+	if _, err := snappyWriter.Write([]byte(want)); err != nil {
+		t.Fatalf("snappy write: %v", err)
+	}
+	if err := snappyWriter.Close(); err != nil {
+		t.Fatalf("snappy close: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		format CompressionFormat
+		body   []byte
+	}{
+		{"none", CompressionFormatNone, []byte(want)},
+		{"gzip", CompressionFormatGzip, gzipBuf.Bytes()},
+		{"zstd", CompressionFormatZstd, zstdBuf.Bytes()},
+		{"snappy", CompressionFormatSnappy, snappyBuf.Bytes()},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			decoded, err := wrapDecompressor(bytes.NewReader(tc.body), tc.format)
+			if err != nil {
+				t.Fatalf("wrapDecompressor: %v", err)
+			}
+			got, err := io.ReadAll(decoded)
+			if err != nil {
+				t.Fatalf("reading decoded body: %v", err)
+			}
+			if string(got) != want {
+				t.Errorf("got %q, want %q", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,146 @@
+package sample
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/Eventual-Inc/Daft/pkg/datarepo/schema"
+	"github.com/Eventual-Inc/Daft/pkg/objectstorage"
+	"github.com/sirupsen/logrus"
+)
+
+// JSONLinesSampler samples schema and rows from a directory of newline-delimited JSON files.
+type JSONLinesSampler struct {
+	objectStore objectstorage.ObjectStore
+	fullDirPath string
+}
+
+func (sampler *JSONLinesSampler) SampleSchema(ctx context.Context, opts ...SamplingOpt) (schema.Schema, error) {
+	// Default to unioning keys across the first 100 rows of the first file found
+	samplingOptions := SamplingOptions{inferenceRows: 100}
+	for _, opt := range opts {
+		opt(&samplingOptions)
+	}
+
+	sampledSchema := schema.Schema{}
+	objectPaths, err := sampler.objectStore.ListObjects(ctx, sampler.fullDirPath)
+	if err != nil {
+		return sampledSchema, err
+	}
+
+	for _, objPath := range objectPaths {
+		if !strings.HasSuffix(objPath, ".jsonl") && !strings.HasSuffix(objPath, ".ndjson") {
+			logrus.Debug(fmt.Sprintf("Skipping non-JSONLines file: %s", objPath))
+			continue
+		}
+
+		objBody, err := sampler.objectStore.DownloadObject(ctx, objPath)
+		if err != nil {
+			return sampledSchema, fmt.Errorf("unable to download object from AWS S3: %w", err)
+		}
+
+		// fieldOrder preserves first-seen order across rows so the resulting schema doesn't
+		// reshuffle field order on every sample
+		var fieldOrder []string
+		columnStates := map[string]*columnTypeState{}
+		scanner := bufio.NewScanner(objBody)
+		for i := 0; (samplingOptions.inferenceRows == 0 || i < samplingOptions.inferenceRows) && scanner.Scan(); i++ {
+			var row map[string]interface{}
+			if err := json.Unmarshal(scanner.Bytes(), &row); err != nil {
+				return sampledSchema, fmt.Errorf("unable to parse JSON line from %s: %w", objPath, err)
+			}
+			for key, value := range row {
+				state, ok := columnStates[key]
+				if !ok {
+					state = &columnTypeState{inferredType: schema.StringType}
+					columnStates[key] = state
+					fieldOrder = append(fieldOrder, key)
+				}
+				state.observeValue(value)
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			return sampledSchema, fmt.Errorf("unable to read JSONLines file %s: %w", objPath, err)
+		}
+		if closer, ok := objBody.(io.Closer); ok {
+			closer.Close()
+		}
+
+		for _, key := range fieldOrder {
+			state := columnStates[key]
+			field := schema.NewPrimitiveField(key, "", state.inferredType)
+			field.Nullable = state.nullable
+			sampledSchema.Fields = append(sampledSchema.Fields, field)
+		}
+		break
+	}
+	return sampledSchema, nil
+}
+
+func (sampler *JSONLinesSampler) SampleRows(ctx context.Context, outputChannel chan [][]byte, opts ...SamplingOpt) error {
+	// Default to sampling 10 rows of data
+	samplingOptions := SamplingOptions{numRows: 10}
+	for _, opt := range opts {
+		opt(&samplingOptions)
+	}
+
+	objectPaths, err := sampler.objectStore.ListObjects(ctx, sampler.fullDirPath)
+	if err != nil {
+		return err
+	}
+
+	detectedSchema := schema.Schema{Fields: samplingOptions.schemaFields}
+	if len(detectedSchema.Fields) == 0 {
+		detectedSchema, err = sampler.SampleSchema(ctx, opts...)
+		if err != nil {
+			return err
+		}
+	}
+
+	remainingRows := samplingOptions.numRows
+	unlimited := samplingOptions.numRows == 0
+
+	for _, objPath := range objectPaths {
+		if !strings.HasSuffix(objPath, ".jsonl") && !strings.HasSuffix(objPath, ".ndjson") {
+			logrus.Debug(fmt.Sprintf("Skipping non-JSONLines file: %s", objPath))
+			continue
+		}
+		if !unlimited && remainingRows <= 0 {
+			break
+		}
+
+		objBody, err := sampler.objectStore.DownloadObject(ctx, objPath)
+		if err != nil {
+			return fmt.Errorf("unable to download object from AWS S3: %w", err)
+		}
+
+		scanner := bufio.NewScanner(objBody)
+		for (unlimited || remainingRows > 0) && scanner.Scan() {
+			var parsed map[string]interface{}
+			if err := json.Unmarshal(scanner.Bytes(), &parsed); err != nil {
+				return fmt.Errorf("unable to parse JSON line from %s: %w", objPath, err)
+			}
+			row := make([][]byte, len(detectedSchema.Fields))
+			for i, field := range detectedSchema.Fields {
+				if value, ok := parsed[field.Name]; ok && value != nil {
+					row[i] = []byte(fmt.Sprintf("%v", value))
+				}
+			}
+			outputChannel <- row
+			if !unlimited {
+				remainingRows--
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			return fmt.Errorf("unable to read JSONLines file %s: %w", objPath, err)
+		}
+		if closer, ok := objBody.(io.Closer); ok {
+			closer.Close()
+		}
+	}
+	return nil
+}
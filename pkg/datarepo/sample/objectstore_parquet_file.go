@@ -0,0 +1,86 @@
+package sample
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/Eventual-Inc/Daft/pkg/objectstorage"
+	"github.com/xitongsys/parquet-go/source"
+)
+
+// objectStoreParquetFile adapts a single object behind an objectstorage.ObjectStore into the
+// source.ParquetFile interface that parquet-go's reader expects, translating each Read into a
+// ranged object-store GET.
+type objectStoreParquetFile struct {
+	ctx         context.Context
+	objectStore objectstorage.ObjectStore
+	objPath     string
+	size        int64
+	offset      int64
+}
+
+func newObjectStoreParquetFile(ctx context.Context, objectStore objectstorage.ObjectStore, objPath string) (*objectStoreParquetFile, error) {
+	size, err := objectStore.ObjectSize(ctx, objPath)
+	if err != nil {
+		return nil, fmt.Errorf("unable to stat parquet object %s: %w", objPath, err)
+	}
+	return &objectStoreParquetFile{ctx: ctx, objectStore: objectStore, objPath: objPath, size: size}, nil
+}
+
+func (f *objectStoreParquetFile) Create(name string) (source.ParquetFile, error) {
+	return nil, fmt.Errorf("objectStoreParquetFile %s is read-only, cannot create %s", f.objPath, name)
+}
+
+func (f *objectStoreParquetFile) Open(name string) (source.ParquetFile, error) {
+	objPath := f.objPath
+	if name != "" {
+		objPath = name
+	}
+	return newObjectStoreParquetFile(f.ctx, f.objectStore, objPath)
+}
+
+func (f *objectStoreParquetFile) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+		f.offset = offset
+	case io.SeekCurrent:
+		f.offset += offset
+	case io.SeekEnd:
+		f.offset = f.size + offset
+	default:
+		return 0, fmt.Errorf("objectStoreParquetFile: unsupported whence %d", whence)
+	}
+	return f.offset, nil
+}
+
+func (f *objectStoreParquetFile) Read(b []byte) (int, error) {
+	if f.offset >= f.size {
+		return 0, io.EOF
+	}
+	length := int64(len(b))
+	if remaining := f.size - f.offset; length > remaining {
+		length = remaining
+	}
+	body, err := f.objectStore.DownloadObject(f.ctx, f.objPath, objectstorage.WithDownloadRange(f.offset, length))
+	if err != nil {
+		return 0, fmt.Errorf("unable to download parquet byte range from %s: %w", f.objPath, err)
+	}
+	if closer, ok := body.(io.Closer); ok {
+		defer closer.Close()
+	}
+	n, err := io.ReadFull(body, b[:length])
+	f.offset += int64(n)
+	if err == io.ErrUnexpectedEOF {
+		err = nil
+	}
+	return n, err
+}
+
+func (f *objectStoreParquetFile) Write(b []byte) (int, error) {
+	return 0, fmt.Errorf("objectStoreParquetFile %s is read-only", f.objPath)
+}
+
+func (f *objectStoreParquetFile) Close() error {
+	return nil
+}
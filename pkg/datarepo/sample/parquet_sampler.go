@@ -0,0 +1,169 @@
+package sample
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Eventual-Inc/Daft/pkg/datarepo/schema"
+	"github.com/Eventual-Inc/Daft/pkg/objectstorage"
+	"github.com/sirupsen/logrus"
+	"github.com/xitongsys/parquet-go/parquet"
+	"github.com/xitongsys/parquet-go/reader"
+)
+
+// ParquetSampler samples schema and rows from a directory of Parquet files.
+type ParquetSampler struct {
+	objectStore objectstorage.ObjectStore
+	fullDirPath string
+}
+
+func (sampler *ParquetSampler) SampleSchema(ctx context.Context, opts ...SamplingOpt) (schema.Schema, error) {
+	sampledSchema := schema.Schema{}
+	objectPaths, err := sampler.objectStore.ListObjects(ctx, sampler.fullDirPath)
+	if err != nil {
+		return sampledSchema, err
+	}
+
+	for _, objPath := range objectPaths {
+		if !strings.HasSuffix(objPath, ".parquet") {
+			logrus.Debug(fmt.Sprintf("Skipping non-Parquet file: %s", objPath))
+			continue
+		}
+
+		// Opening the column reader only pulls and decodes the footer; no row group is touched.
+		pr, err := sampler.openColumnReader(ctx, objPath)
+		if err != nil {
+			return sampledSchema, err
+		}
+
+		// SchemaElements[0] is the synthetic root "message"; every entry after it is a leaf field
+		for _, element := range pr.SchemaHandler.SchemaElements[1:] {
+			fieldType, nullable := convertParquetType(element)
+			field := schema.NewPrimitiveField(element.GetName(), "", fieldType)
+			field.Nullable = nullable
+			sampledSchema.Fields = append(sampledSchema.Fields, field)
+		}
+		pr.PFile.Close()
+		break
+	}
+	return sampledSchema, nil
+}
+
+func (sampler *ParquetSampler) SampleRows(ctx context.Context, outputChannel chan [][]byte, opts ...SamplingOpt) error {
+	// Default to sampling 10 rows of data
+	samplingOptions := SamplingOptions{numRows: 10}
+	for _, opt := range opts {
+		opt(&samplingOptions)
+	}
+
+	objectPaths, err := sampler.objectStore.ListObjects(ctx, sampler.fullDirPath)
+	if err != nil {
+		return err
+	}
+
+	remainingRows := samplingOptions.numRows
+	unlimited := samplingOptions.numRows == 0
+
+	for _, objPath := range objectPaths {
+		if !strings.HasSuffix(objPath, ".parquet") {
+			logrus.Debug(fmt.Sprintf("Skipping non-Parquet file: %s", objPath))
+			continue
+		}
+		if !unlimited && remainingRows <= 0 {
+			break
+		}
+
+		pr, err := sampler.openColumnReader(ctx, objPath)
+		if err != nil {
+			return err
+		}
+
+		numFields := len(pr.SchemaHandler.SchemaElements) - 1
+		rowsToRead := remainingRows
+		if unlimited || int64(rowsToRead) > pr.GetNumRows() {
+			rowsToRead = int(pr.GetNumRows())
+		}
+
+		columns, err := readParquetColumns(pr, numFields, rowsToRead, objPath)
+		if err != nil {
+			return err
+		}
+
+		for rowIdx := 0; rowIdx < rowsToRead; rowIdx++ {
+			row := make([][]byte, numFields)
+			for col := 0; col < numFields; col++ {
+				if rowIdx < len(columns[col]) {
+					row[col] = []byte(fmt.Sprintf("%v", columns[col][rowIdx]))
+				}
+			}
+			outputChannel <- row
+			if !unlimited {
+				remainingRows--
+			}
+		}
+	}
+	return nil
+}
+
+// readParquetColumns reads rowsToRead values from each of numFields columns of pr, closing
+// pr.PFile once it's done regardless of whether a column read fails partway through.
+func readParquetColumns(pr *reader.ParquetColumnReader, numFields int, rowsToRead int, objPath string) ([][]interface{}, error) {
+	defer pr.PFile.Close()
+
+	columns := make([][]interface{}, numFields)
+	for i := 0; i < numFields; i++ {
+		values, _, _, err := pr.ReadColumnByIndex(int64(i), int64(rowsToRead))
+		if err != nil {
+			return nil, fmt.Errorf("unable to read parquet column %d from %s: %w", i, objPath, err)
+		}
+		columns[i] = values
+	}
+	return columns, nil
+}
+
+func (sampler *ParquetSampler) openColumnReader(ctx context.Context, objPath string) (*reader.ParquetColumnReader, error) {
+	pf, err := newObjectStoreParquetFile(ctx, sampler.objectStore, objPath)
+	if err != nil {
+		return nil, err
+	}
+	pr, err := reader.NewParquetColumnReader(pf, 1)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read parquet footer from %s: %w", objPath, err)
+	}
+	return pr, nil
+}
+
+// convertParquetType translates a Parquet schema element's physical/logical type into the
+// closest schema.PrimitiveType, falling back to StringType for anything we don't special-case
+// (e.g. nested groups, which SampleSchema doesn't currently flatten).
+func convertParquetType(element *parquet.SchemaElement) (fieldType schema.PrimitiveType, nullable bool) {
+	nullable = element.GetRepetitionType() == parquet.FieldRepetitionType_OPTIONAL
+
+	if element.IsSetConvertedType() {
+		switch element.GetConvertedType() {
+		case parquet.ConvertedType_UTF8, parquet.ConvertedType_ENUM, parquet.ConvertedType_JSON:
+			return schema.StringType, nullable
+		case parquet.ConvertedType_INT_8, parquet.ConvertedType_INT_16, parquet.ConvertedType_INT_32,
+			parquet.ConvertedType_INT_64, parquet.ConvertedType_UINT_8, parquet.ConvertedType_UINT_16,
+			parquet.ConvertedType_UINT_32, parquet.ConvertedType_UINT_64:
+			return schema.Int64Type, nullable
+		case parquet.ConvertedType_DATE, parquet.ConvertedType_TIMESTAMP_MILLIS, parquet.ConvertedType_TIMESTAMP_MICROS:
+			return schema.TimestampType, nullable
+		}
+	}
+
+	if element.Type == nil {
+		return schema.StringType, nullable
+	}
+	switch *element.Type {
+	case parquet.Type_BOOLEAN:
+		return schema.BoolType, nullable
+	case parquet.Type_INT32, parquet.Type_INT64, parquet.Type_INT96:
+		return schema.Int64Type, nullable
+	case parquet.Type_FLOAT, parquet.Type_DOUBLE:
+		return schema.Float64Type, nullable
+	default:
+		return schema.StringType, nullable
+	}
+}
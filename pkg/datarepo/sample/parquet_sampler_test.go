@@ -0,0 +1,81 @@
+package sample
+
+import (
+	"testing"
+
+	"github.com/Eventual-Inc/Daft/pkg/datarepo/schema"
+	"github.com/xitongsys/parquet-go/parquet"
+)
+
+func parquetType(t parquet.Type) *parquet.Type { return &t }
+
+func TestConvertParquetType(t *testing.T) {
+	convertedType := func(ct parquet.ConvertedType) *parquet.ConvertedType { return &ct }
+
+	tests := []struct {
+		name         string
+		element      *parquet.SchemaElement
+		wantType     schema.PrimitiveType
+		wantNullable bool
+	}{
+		{
+			name: "optional UTF8 string",
+			element: &parquet.SchemaElement{
+				Type:           parquetType(parquet.Type_BYTE_ARRAY),
+				ConvertedType:  convertedType(parquet.ConvertedType_UTF8),
+				RepetitionType: func() *parquet.FieldRepetitionType { r := parquet.FieldRepetitionType_OPTIONAL; return &r }(),
+			},
+			wantType:     schema.StringType,
+			wantNullable: true,
+		},
+		{
+			name: "required int64 via converted type",
+			element: &parquet.SchemaElement{
+				Type:           parquetType(parquet.Type_INT64),
+				ConvertedType:  convertedType(parquet.ConvertedType_INT_64),
+				RepetitionType: func() *parquet.FieldRepetitionType { r := parquet.FieldRepetitionType_REQUIRED; return &r }(),
+			},
+			wantType:     schema.Int64Type,
+			wantNullable: false,
+		},
+		{
+			name: "bare boolean physical type",
+			element: &parquet.SchemaElement{
+				Type: parquetType(parquet.Type_BOOLEAN),
+			},
+			wantType: schema.BoolType,
+		},
+		{
+			name: "bare double physical type",
+			element: &parquet.SchemaElement{
+				Type: parquetType(parquet.Type_DOUBLE),
+			},
+			wantType: schema.Float64Type,
+		},
+		{
+			name: "date converted type",
+			element: &parquet.SchemaElement{
+				Type:          parquetType(parquet.Type_INT32),
+				ConvertedType: convertedType(parquet.ConvertedType_DATE),
+			},
+			wantType: schema.TimestampType,
+		},
+		{
+			name:     "group with no physical type falls back to string",
+			element:  &parquet.SchemaElement{},
+			wantType: schema.StringType,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			gotType, gotNullable := convertParquetType(tc.element)
+			if gotType != tc.wantType {
+				t.Errorf("convertParquetType() type = %v, want %v", gotType, tc.wantType)
+			}
+			if gotNullable != tc.wantNullable {
+				t.Errorf("convertParquetType() nullable = %v, want %v", gotNullable, tc.wantNullable)
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package sample
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/Eventual-Inc/Daft/pkg/datarepo/schema"
+)
+
+// Value is a single typed cell, tagged with the PrimitiveType its column was inferred (or
+// declared) to hold. Exactly one of the typed fields is meaningful unless Null is set.
+type Value struct {
+	Type    schema.PrimitiveType
+	Null    bool
+	Bool    bool
+	Int64   int64
+	Float64 float64
+	Time    time.Time
+	Str     string
+
+	// Raw holds the cell exactly as it appeared in the source file, independent of Type/Null.
+	Raw string
+}
+
+// Row is a single sampled record, keyed by column name.
+type Row map[string]Value
+
+// RecordBatch groups a batch of Rows that share the same Schema into a single channel send.
+type RecordBatch struct {
+	Schema schema.Schema
+	Rows   []Row
+}
+
+// recordBatchSize is the number of rows buffered into a RecordBatch before it's sent.
+const recordBatchSize = 1024
+
+// parseValue converts a raw CSV cell into a Value of fieldType, treating any of
+// defaultNullSentinels as null. If cell doesn't parse as fieldType, it falls back to a
+// StringType Value holding the raw cell rather than erroring.
+func parseValue(cell string, fieldType schema.PrimitiveType) Value {
+	if isNullSentinel(cell, defaultNullSentinels) {
+		return Value{Type: fieldType, Null: true, Raw: cell}
+	}
+	switch fieldType {
+	case schema.Int64Type:
+		if parsed, err := strconv.ParseInt(cell, 10, 64); err == nil {
+			return Value{Type: fieldType, Int64: parsed, Raw: cell}
+		}
+	case schema.Float64Type:
+		if parsed, err := strconv.ParseFloat(cell, 64); err == nil {
+			return Value{Type: fieldType, Float64: parsed, Raw: cell}
+		}
+	case schema.BoolType:
+		// Matches inferCellType's use of isBoolLiteral rather than the permissive ParseBool.
+		if isBoolLiteral(cell) {
+			parsed, _ := strconv.ParseBool(cell)
+			return Value{Type: fieldType, Bool: parsed, Raw: cell}
+		}
+	case schema.TimestampType:
+		for _, layout := range timestampLayouts {
+			if parsed, err := time.Parse(layout, cell); err == nil {
+				return Value{Type: fieldType, Time: parsed, Raw: cell}
+			}
+		}
+	}
+	return Value{Type: schema.StringType, Str: cell, Raw: cell}
+}
+
+// String renders v's parsed value back into CSV-cell-shaped text. It need not match the source
+// cell byte-for-byte (e.g. "00501" parsed as Int64Type renders as "501"); Raw holds that text.
+func (v Value) String() string {
+	if v.Null {
+		return ""
+	}
+	switch v.Type {
+	case schema.Int64Type:
+		return strconv.FormatInt(v.Int64, 10)
+	case schema.Float64Type:
+		return strconv.FormatFloat(v.Float64, 'f', -1, 64)
+	case schema.BoolType:
+		return strconv.FormatBool(v.Bool)
+	case schema.TimestampType:
+		return v.Time.Format(time.RFC3339)
+	default:
+		return v.Str
+	}
+}
+
+// rowToByteRow adapts a typed Row back into the legacy [][]byte shape that SampleRows returns,
+// preserving fields' order. It reads Value.Raw rather than re-serializing the parsed value, so
+// the original cell text reaches byte-channel consumers unchanged.
+func rowToByteRow(fields []schema.SchemaField, row Row) [][]byte {
+	byteRow := make([][]byte, len(fields))
+	for i, field := range fields {
+		byteRow[i] = []byte(row[field.Name].Raw)
+	}
+	return byteRow
+}
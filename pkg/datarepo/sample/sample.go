@@ -5,25 +5,29 @@ import (
 	"encoding/csv"
 	"fmt"
 	"io"
+	"runtime"
 	"strings"
+	"sync/atomic"
 
 	"github.com/Eventual-Inc/Daft/pkg/datarepo"
 	"github.com/Eventual-Inc/Daft/pkg/datarepo/schema"
 	"github.com/Eventual-Inc/Daft/pkg/objectstorage"
 	"github.com/sirupsen/logrus"
+	"golang.org/x/sync/errgroup"
 )
 
 // A Sampler retrieves data, when provided with a Datasource and Data format
 type Sampler interface {
-	SampleSchema() (schema.Schema, error)
-	SampleRows(outputChannel chan [][]byte, opts ...SamplingOpt) error
+	SampleSchema(ctx context.Context, opts ...SamplingOpt) (schema.Schema, error)
+	SampleRows(ctx context.Context, outputChannel chan [][]byte, opts ...SamplingOpt) error
 }
 
 type CSVSampler struct {
-	objectStore objectstorage.ObjectStore
-	delimiter   rune
-	fullDirPath string
-	hasHeaders  bool
+	objectStore               objectstorage.ObjectStore
+	delimiter                 rune
+	fullDirPath               string
+	hasHeaders                bool
+	compressionFormatOverride datarepo.CompressionFormatConfig
 }
 
 type SampleResult struct {
@@ -37,10 +41,48 @@ type SamplingOptions struct {
 
 	// Schema to use, or nil if not provided and need to detect
 	schemaFields []schema.SchemaField
+
+	// Number of files to sample concurrently
+	concurrency int
+
+	// Number of rows to read per column when inferring types in SampleSchema
+	inferenceRows int
+
+	// Forwarded to csv.Reader.FieldsPerRecord; 0 means "infer from the first record", a
+	// negative value disables the check entirely
+	fieldsPerRecord int
+
+	// Forwarded to csv.Reader.LazyQuotes
+	lazyQuotes bool
 }
 
 type SamplingOpt = func(*SamplingOptions)
 
+// WithInferenceRows sets how many data rows SampleSchema reads per column when inferring
+// column types. Defaults to 100.
+func WithInferenceRows(k int) SamplingOpt {
+	return func(opt *SamplingOptions) {
+		opt.inferenceRows = k
+	}
+}
+
+// WithFieldsPerRecord forwards n to csv.Reader.FieldsPerRecord, letting callers sample CSVs
+// whose rows don't all share the same column count. Pass a negative number to disable Go's
+// built-in fields-per-record check altogether.
+func WithFieldsPerRecord(n int) SamplingOpt {
+	return func(opt *SamplingOptions) {
+		opt.fieldsPerRecord = n
+	}
+}
+
+// WithLazyQuotes forwards lazy to csv.Reader.LazyQuotes, letting callers sample CSVs with
+// unescaped quotes embedded in unquoted fields.
+func WithLazyQuotes(lazy bool) SamplingOpt {
+	return func(opt *SamplingOptions) {
+		opt.lazyQuotes = lazy
+	}
+}
+
 func WithSampleAll() SamplingOpt {
 	return func(opt *SamplingOptions) {
 		opt.numRows = 0
@@ -53,8 +95,21 @@ func WithSchema(usingSchema schema.Schema) SamplingOpt {
 	}
 }
 
-func (sampler *CSVSampler) SampleSchema() (schema.Schema, error) {
-	ctx := context.TODO()
+// WithConcurrency sets the number of files that are downloaded and parsed at the same time.
+// Defaults to runtime.NumCPU() when left unset.
+func WithConcurrency(n int) SamplingOpt {
+	return func(opt *SamplingOptions) {
+		opt.concurrency = n
+	}
+}
+
+func (sampler *CSVSampler) SampleSchema(ctx context.Context, opts ...SamplingOpt) (schema.Schema, error) {
+	// Default to inferring types off of the first 100 rows of the first file found
+	samplingOptions := SamplingOptions{inferenceRows: 100}
+	for _, opt := range opts {
+		opt(&samplingOptions)
+	}
+
 	sampledSchema := schema.Schema{}
 	objectPaths, err := sampler.objectStore.ListObjects(ctx, sampler.fullDirPath)
 	if err != nil {
@@ -62,49 +117,123 @@ func (sampler *CSVSampler) SampleSchema() (schema.Schema, error) {
 	}
 
 	for _, objPath := range objectPaths {
-		// Skip files that are not CSV or TSV
-		if !strings.HasSuffix(objPath, ".csv") && !strings.HasSuffix(objPath, ".tsv") {
+		compressionFormat, trimmedPath := resolveCompressionFormat(objPath, sampler.compressionFormatOverride)
+		// Skip files that are not CSV or TSV, ignoring any compression extension
+		if !strings.HasSuffix(trimmedPath, ".csv") && !strings.HasSuffix(trimmedPath, ".tsv") {
 			logrus.Debug(fmt.Sprintf("Skipping non-CSV file: %s", objPath))
 			continue
 		}
 
-		// TODO(jaychia): Download up to 100KB, assumes that header wont exceed that size
-		objBody, err := sampler.objectStore.DownloadObject(ctx, objPath, objectstorage.WithDownloadRange(0, 100000))
+		// The byte-range trick below only makes sense for uncompressed streams, since a
+		// compressed file's header can land anywhere within the first bytes of the codec's
+		// frame. For compressed objects we instead download the whole object and rely on the
+		// reader.Read() below returning after enough rows have been read for inference.
+		var downloadOptions []objectstorage.DownloadObjectOption
+		if compressionFormat == CompressionFormatNone {
+			// TODO(jaychia): Download up to 100KB, assumes that header + inference rows wont exceed that size
+			downloadOptions = append(downloadOptions, objectstorage.WithDownloadRange(0, 100000))
+		}
+		objBody, err := sampler.objectStore.DownloadObject(ctx, objPath, downloadOptions...)
 		if err != nil {
 			return sampledSchema, fmt.Errorf("unable to download object from AWS S3: %w", err)
 		}
-		reader := csv.NewReader(objBody)
+		decodedBody, err := wrapDecompressor(objBody, compressionFormat)
+		if err != nil {
+			return sampledSchema, fmt.Errorf("unable to decompress object %s: %w", objPath, err)
+		}
+		reader := csv.NewReader(decodedBody)
 		reader.Comma = sampler.delimiter
+		reader.FieldsPerRecord = samplingOptions.fieldsPerRecord
+		reader.LazyQuotes = samplingOptions.lazyQuotes
 
 		// Parse or generate headers using first file found
-		record, err := reader.Read()
+		header, err := reader.Read()
 		if err != nil {
 			return sampledSchema, fmt.Errorf("unable to read header from CSV file: %w", err)
 		}
-		for i, cell := range record {
+
+		// Read up to inferenceRows data rows to infer a type and nullability per column,
+		// promoting each column's type to the most general one any observed value requires
+		columnStates := make([]columnTypeState, len(header))
+		for i := range columnStates {
+			columnStates[i].inferredType = schema.StringType
+		}
+		for i := 0; samplingOptions.inferenceRows == 0 || i < samplingOptions.inferenceRows; i++ {
+			record, err := reader.Read()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return sampledSchema, fmt.Errorf("unable to read row from CSV file while inferring schema: %w", err)
+			}
+			// A malformed row (e.g. with FieldsPerRecord disabled via WithFieldsPerRecord) may
+			// have more fields than the header; ignore any that don't map to a known column
+			// rather than indexing columnStates out of range.
+			for col, cell := range record {
+				if col >= len(columnStates) {
+					break
+				}
+				columnStates[col].observe(cell, defaultNullSentinels)
+			}
+		}
+
+		for i, cell := range header {
 			fieldName := fmt.Sprintf("col_%d", i)
 			if sampler.hasHeaders {
 				fieldName = cell
 			}
-			sampledSchema.Fields = append(sampledSchema.Fields, schema.NewPrimitiveField(
+			field := schema.NewPrimitiveField(
 				fieldName,
 				"",
-				schema.StringType,
-			))
+				columnStates[i].inferredType,
+			)
+			field.Nullable = columnStates[i].nullable
+			sampledSchema.Fields = append(sampledSchema.Fields, field)
+		}
+		if closer, ok := objBody.(io.Closer); ok {
+			closer.Close()
 		}
 		break
 	}
 	return sampledSchema, nil
 }
 
-func (sampler *CSVSampler) SampleRows(outputChannel chan [][]byte, opts ...SamplingOpt) error {
-	// Default to sampling 10 rows of data
-	samplingOptions := SamplingOptions{numRows: 10}
+// SampleRows samples rows into the legacy [][]byte shape. It is implemented on top of
+// SampleRowsTyped, passing each cell's original text (Value.Raw) through unchanged, so that
+// callers who haven't migrated to the typed API keep working unchanged. outputChannel is closed
+// once sampling finishes.
+func (sampler *CSVSampler) SampleRows(ctx context.Context, outputChannel chan [][]byte, opts ...SamplingOpt) error {
+	typedChannel := make(chan *RecordBatch)
+	relayDone := make(chan struct{})
+	go func() {
+		defer close(relayDone)
+		for batch := range typedChannel {
+			for _, row := range batch.Rows {
+				outputChannel <- rowToByteRow(batch.Schema.Fields, row)
+			}
+		}
+	}()
+
+	err := sampler.SampleRowsTyped(ctx, typedChannel, opts...)
+	<-relayDone
+	close(outputChannel)
+	return err
+}
+
+// SampleRowsTyped is the typed counterpart to SampleRows: it parses each CSV cell into a Value
+// tagged with the column's inferred PrimitiveType instead of leaving it as raw bytes, and
+// batches recordBatchSize rows per channel send to keep channel overhead a small fraction of
+// total sampling time. SampleRowsTyped closes out once every file has been sampled (or ctx is
+// cancelled, or a file sample fails).
+func (sampler *CSVSampler) SampleRowsTyped(ctx context.Context, out chan *RecordBatch, opts ...SamplingOpt) error {
+	defer close(out)
+
+	// Default to sampling 10 rows of data, across as many files concurrently as we have cores
+	samplingOptions := SamplingOptions{numRows: 10, concurrency: runtime.NumCPU()}
 	for _, opt := range opts {
 		opt(&samplingOptions)
 	}
 
-	ctx := context.TODO()
 	objectPaths, err := sampler.objectStore.ListObjects(ctx, sampler.fullDirPath)
 	if err != nil {
 		return err
@@ -113,69 +242,167 @@ func (sampler *CSVSampler) SampleRows(outputChannel chan [][]byte, opts ...Sampl
 	// Use schema if provided as an opt, otherwise detect it first
 	detectedSchema := schema.Schema{Fields: samplingOptions.schemaFields}
 	if len(detectedSchema.Fields) == 0 {
-		detectedSchema, err = sampler.SampleSchema()
+		detectedSchema, err = sampler.SampleSchema(ctx, opts...)
 		if err != nil {
 			return err
 		}
 	}
 
-	// If sampling N number of rows, we limit the downloads to just the top 100KB * (N/num_files) amount of bytes
-	var downloadOptions []objectstorage.DownloadObjectOption
-	numRowsPerFile := 0
-	if samplingOptions.numRows != 0 {
-		numRowsPerFile = samplingOptions.numRows / len(objectPaths)
-		sizePerRow := 100000
-		sizePerFile := sizePerRow * numRowsPerFile
-		downloadOptions = append(downloadOptions, objectstorage.WithDownloadRange(0, sizePerFile))
-	}
+	// remainingRows is a shared budget that every worker claims rows from before reading, so
+	// the total number of rows sent to out never exceeds numRows regardless of how many files
+	// run concurrently or how unevenly rows are distributed across them. A claimed row that a
+	// file can't deliver (EOF or error) is handed back so other in-flight workers can use it
+	// instead of the budget being silently lost, as a static numRows/len(files) split would do
+	// for empty or short files.
+	unlimited := samplingOptions.numRows == 0
+	remainingRows := int64(samplingOptions.numRows)
+
+	group, groupCtx := errgroup.WithContext(ctx)
+	group.SetLimit(samplingOptions.concurrency)
 
 	for _, objPath := range objectPaths {
-		// Skip files that are not CSV or TSV
-		if !strings.HasSuffix(objPath, ".csv") && !strings.HasSuffix(objPath, ".tsv") {
+		objPath := objPath
+		compressionFormat, trimmedPath := resolveCompressionFormat(objPath, sampler.compressionFormatOverride)
+		// Skip files that are not CSV or TSV, ignoring any compression extension
+		if !strings.HasSuffix(trimmedPath, ".csv") && !strings.HasSuffix(trimmedPath, ".tsv") {
 			logrus.Debug(fmt.Sprintf("Skipping non-CSV file: %s", objPath))
 			continue
 		}
 
-		// Download object and start reading with a CSV Reader
-		objBody, err := sampler.objectStore.DownloadObject(ctx, objPath, downloadOptions...)
-		if err != nil {
-			return fmt.Errorf("unable to download object from AWS S3: %w", err)
+		group.Go(func() error {
+			return sampler.sampleTypedRowsFromFile(groupCtx, objPath, compressionFormat, detectedSchema, out, unlimited, &remainingRows, samplingOptions)
+		})
+	}
+
+	return group.Wait()
+}
+
+// sampleTypedRowsFromFile downloads and parses a single CSV/TSV object, sending recordBatchSize
+// rows at a time to out until either the file is exhausted or remainingRows (shared across all
+// in-flight files) reaches zero. It is safe to call concurrently for different objPaths.
+func (sampler *CSVSampler) sampleTypedRowsFromFile(
+	ctx context.Context,
+	objPath string,
+	compressionFormat CompressionFormat,
+	detectedSchema schema.Schema,
+	out chan *RecordBatch,
+	unlimited bool,
+	remainingRows *int64,
+	samplingOptions SamplingOptions,
+) error {
+	if !unlimited && atomic.LoadInt64(remainingRows) <= 0 {
+		return nil
+	}
+
+	// The byte-range trick doesn't apply to compressed objects: we can't know how many
+	// compressed bytes decode to the rows we still need, so we download the whole object and
+	// instead stop reading (and close the body) once the shared budget runs out.
+	var downloadOptions []objectstorage.DownloadObjectOption
+	if compressionFormat == CompressionFormatNone && !unlimited {
+		sizePerRow := 100000
+		downloadOptions = append(downloadOptions, objectstorage.WithDownloadRange(0, sizePerRow*int(atomic.LoadInt64(remainingRows))))
+	}
+
+	// Download object and start reading with a CSV Reader
+	objBody, err := sampler.objectStore.DownloadObject(ctx, objPath, downloadOptions...)
+	if err != nil {
+		return fmt.Errorf("unable to download object from AWS S3: %w", err)
+	}
+	defer func() {
+		if closer, ok := objBody.(io.Closer); ok {
+			closer.Close()
 		}
-		reader := csv.NewReader(objBody)
-		reader.Comma = sampler.delimiter
+	}()
+	decodedBody, err := wrapDecompressor(objBody, compressionFormat)
+	if err != nil {
+		return fmt.Errorf("unable to decompress object %s: %w", objPath, err)
+	}
+	reader := csv.NewReader(decodedBody)
+	reader.Comma = sampler.delimiter
+	reader.FieldsPerRecord = samplingOptions.fieldsPerRecord
+	reader.LazyQuotes = samplingOptions.lazyQuotes
 
-		// Skip first row if hasHeaders
-		if sampler.hasHeaders {
-			_, err := reader.Read()
-			if err != nil {
-				return err
-			}
+	// Skip first row if hasHeaders
+	if sampler.hasHeaders {
+		if _, err := reader.Read(); err != nil {
+			return err
 		}
+	}
 
-		for i := 0; i < numRowsPerFile || numRowsPerFile == 0; i++ {
-			record, err := reader.Read()
-			if err == io.EOF {
-				break
+	flush := func(rows []Row) error {
+		if len(rows) == 0 {
+			return nil
+		}
+		select {
+		case out <- &RecordBatch{Schema: detectedSchema, Rows: rows}:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	batch := make([]Row, 0, recordBatchSize)
+	for {
+		if !unlimited && atomic.AddInt64(remainingRows, -1) < 0 {
+			return flush(batch)
+		}
+		record, err := reader.Read()
+		if err == io.EOF {
+			if !unlimited {
+				atomic.AddInt64(remainingRows, 1)
 			}
-			if err != nil {
-				return err
+			return flush(batch)
+		}
+		if err != nil {
+			if !unlimited {
+				atomic.AddInt64(remainingRows, 1)
+			}
+			return err
+		}
+		row := make(Row, len(detectedSchema.Fields))
+		for i, field := range detectedSchema.Fields {
+			// A malformed row (e.g. with FieldsPerRecord disabled) may have fewer fields than
+			// the schema; treat the missing trailing fields as null rather than indexing record
+			// out of range. Extra fields beyond len(detectedSchema.Fields) are simply ignored
+			// since this loop only ranges over the schema's fields.
+			if i >= len(record) {
+				row[field.Name] = Value{Type: field.Type, Null: true}
+				continue
 			}
-			var row [][]byte
-			for i, _ := range detectedSchema.Fields {
-				row = append(row, []byte(record[i]))
+			row[field.Name] = parseValue(record[i], field.Type)
+		}
+		batch = append(batch, row)
+		if len(batch) == recordBatchSize {
+			if err := flush(batch); err != nil {
+				return err
 			}
-			outputChannel <- row
+			batch = make([]Row, 0, recordBatchSize)
 		}
-
 	}
-	return nil
 }
 
+// getFullDirPath derives the scheme-qualified directory path (e.g. "s3://bucket/prefix") that
+// identifies locationConfig's directory to its ObjectStore. CSVSampler and the other Samplers
+// only ever see this string and the objectstorage.ObjectStore interface it's passed alongside,
+// so adding a new datarepo.ManifestConfig Kind here (and a matching case in
+// datarepo.ObjectStoreFactory) is enough to support a new backend - no Sampler changes needed.
 func getFullDirPath(locationConfig datarepo.ManifestConfig) (string, error) {
 	switch locationConfig.Kind() {
 	case datarepo.DatasourceIDAWSS3:
 		config := locationConfig.(*datarepo.AWSS3LocationConfig)
 		return fmt.Sprintf("s3://%s/%s", config.Bucket, config.Prefix), nil
+	case datarepo.DatasourceIDGCS:
+		config := locationConfig.(*datarepo.GCSLocationConfig)
+		return fmt.Sprintf("gs://%s/%s", config.Bucket, config.Prefix), nil
+	case datarepo.DatasourceIDAzureBlob:
+		config := locationConfig.(*datarepo.AzureBlobLocationConfig)
+		return fmt.Sprintf("az://%s/%s", config.Container, config.Prefix), nil
+	case datarepo.DatasourceIDLocalFS:
+		config := locationConfig.(*datarepo.LocalFSLocationConfig)
+		return fmt.Sprintf("file://%s", config.Path), nil
+	case datarepo.DatasourceIDHTTP:
+		config := locationConfig.(*datarepo.HTTPLocationConfig)
+		return config.URL, nil
 	default:
 		return "", fmt.Errorf("object store for %s not implemented", locationConfig.Kind())
 	}
@@ -194,12 +421,33 @@ func SamplerFactory(formatConfig datarepo.ManifestConfig, locationConfig datarep
 			return nil, err
 		}
 		sampler := &CSVSampler{
-			objectStore: objectStore,
-			fullDirPath: fullDirPath,
-			delimiter:   datarepo.DelimiterMap[config.Delimiter],
-			hasHeaders:  config.Header,
+			objectStore:               objectStore,
+			fullDirPath:               fullDirPath,
+			delimiter:                 datarepo.DelimiterMap[config.Delimiter],
+			hasHeaders:                config.Header,
+			compressionFormatOverride: config.CompressionFormatConfig,
 		}
 		return sampler, nil
+	case datarepo.DataformatIDParquetFiles:
+		objectStore, err := datarepo.ObjectStoreFactory(locationConfig)
+		if err != nil {
+			return nil, err
+		}
+		fullDirPath, err := getFullDirPath(locationConfig)
+		if err != nil {
+			return nil, err
+		}
+		return &ParquetSampler{objectStore: objectStore, fullDirPath: fullDirPath}, nil
+	case datarepo.DataformatIDJSONLinesFiles:
+		objectStore, err := datarepo.ObjectStoreFactory(locationConfig)
+		if err != nil {
+			return nil, err
+		}
+		fullDirPath, err := getFullDirPath(locationConfig)
+		if err != nil {
+			return nil, err
+		}
+		return &JSONLinesSampler{objectStore: objectStore, fullDirPath: fullDirPath}, nil
 	default:
 		return nil, fmt.Errorf("sampler for %s not implemented", formatConfig.Kind())
 	}
@@ -0,0 +1,122 @@
+package sample
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/Eventual-Inc/Daft/pkg/datarepo/schema"
+	"github.com/Eventual-Inc/Daft/pkg/objectstorage"
+)
+
+// fakeObjectStore serves CSV bytes out of an in-memory map, keyed by object path, so
+// SampleRowsTyped can be exercised without a real backend.
+type fakeObjectStore struct {
+	files map[string]string
+}
+
+func (f *fakeObjectStore) ListObjects(ctx context.Context, dirPath string) ([]string, error) {
+	var paths []string
+	for path := range f.files {
+		paths = append(paths, path)
+	}
+	return paths, nil
+}
+
+func (f *fakeObjectStore) DownloadObject(ctx context.Context, objPath string, opts ...objectstorage.DownloadObjectOption) (io.Reader, error) {
+	return strings.NewReader(f.files[objPath]), nil
+}
+
+func (f *fakeObjectStore) ObjectSize(ctx context.Context, objPath string) (int64, error) {
+	return int64(len(f.files[objPath])), nil
+}
+
+// TestSampleRowsTypedRespectsRowBudgetAcrossFiles checks that the shared remainingRows budget in
+// SampleRowsTyped caps the total rows delivered at numRows even when several files are sampled
+// concurrently, rather than each file independently being allowed up to numRows.
+func TestSampleRowsTypedRespectsRowBudgetAcrossFiles(t *testing.T) {
+	store := &fakeObjectStore{
+		files: map[string]string{
+			"a.csv": "id,name\n1,a\n2,b\n3,c\n4,d\n5,e\n",
+			"b.csv": "id,name\n6,f\n7,g\n8,h\n9,i\n10,j\n",
+		},
+	}
+	sampler := &CSVSampler{
+		objectStore: store,
+		delimiter:   ',',
+		fullDirPath: "fake://bucket/prefix",
+		hasHeaders:  true,
+	}
+
+	testSchema := schema.Schema{Fields: []schema.SchemaField{
+		schema.NewPrimitiveField("id", "", schema.Int64Type),
+		schema.NewPrimitiveField("name", "", schema.StringType),
+	}}
+
+	out := make(chan *RecordBatch)
+	const numRows = 4
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- sampler.SampleRowsTyped(context.Background(), out, WithSchema(testSchema), func(opt *SamplingOptions) {
+			opt.numRows = numRows
+			opt.concurrency = 2
+		})
+	}()
+
+	totalRows := 0
+	for batch := range out {
+		totalRows += len(batch.Rows)
+	}
+	if err := <-errCh; err != nil {
+		t.Fatalf("SampleRowsTyped returned error: %v", err)
+	}
+	if totalRows != numRows {
+		t.Fatalf("expected exactly %d rows across both files, got %d", numRows, totalRows)
+	}
+}
+
+// TestSampleRowsPreservesRawCellText guards the regression fixed in 87c1694: SampleRows used to
+// re-serialize each parsed Value back into text, which turned a zip code like "00501" (inferred
+// as Int64Type) into "501". It must come back byte-for-byte as it appeared in the source file.
+func TestSampleRowsPreservesRawCellText(t *testing.T) {
+	store := &fakeObjectStore{
+		files: map[string]string{
+			"zipcodes.csv": "zip,population\n00501,2\n00544,1\n",
+		},
+	}
+	sampler := &CSVSampler{
+		objectStore: store,
+		delimiter:   ',',
+		fullDirPath: "fake://bucket/prefix",
+		hasHeaders:  true,
+	}
+
+	out := make(chan [][]byte)
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- sampler.SampleRows(context.Background(), out, WithSampleAll())
+	}()
+
+	var rows [][]string
+	for row := range out {
+		cells := make([]string, len(row))
+		for i, cell := range row {
+			cells[i] = string(cell)
+		}
+		rows = append(rows, cells)
+	}
+	if err := <-errCh; err != nil {
+		t.Fatalf("SampleRows returned error: %v", err)
+	}
+
+	want := [][]string{{"00501", "2"}, {"00544", "1"}}
+	if len(rows) != len(want) {
+		t.Fatalf("got %d rows, want %d: %v", len(rows), len(want), rows)
+	}
+	for i, row := range rows {
+		if row[0] != want[i][0] || row[1] != want[i][1] {
+			t.Errorf("row %d = %v, want %v", i, row, want[i])
+		}
+	}
+}
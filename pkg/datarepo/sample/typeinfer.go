@@ -0,0 +1,127 @@
+package sample
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Eventual-Inc/Daft/pkg/datarepo/schema"
+)
+
+// defaultNullSentinels lists the cell values, including the empty string, that are treated as a
+// SQL NULL rather than as the literal string when inferring a column's type.
+var defaultNullSentinels = []string{"", "NA", "NULL"}
+
+// timestampLayouts are tried in order when a cell doesn't parse as a number or bool; the first
+// layout that parses the entire cell wins.
+var timestampLayouts = []string{
+	time.RFC3339,
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+	"01/02/2006",
+}
+
+// columnTypeState accumulates what's been observed for a single column across sampled rows.
+type columnTypeState struct {
+	inferredType schema.PrimitiveType
+	seenValue    bool
+	nullable     bool
+}
+
+// observe folds a single cell's value into the column's running inference state, promoting
+// inferredType to the narrowest type that still accommodates every non-null value seen so far.
+func (state *columnTypeState) observe(value string, nullSentinels []string) {
+	if isNullSentinel(value, nullSentinels) {
+		state.nullable = true
+		return
+	}
+	cellType := inferCellType(value)
+	if !state.seenValue {
+		state.inferredType = cellType
+	} else {
+		state.inferredType = promote(state.inferredType, cellType)
+	}
+	state.seenValue = true
+}
+
+// observeValue is the JSON-value counterpart to observe, used by JSONLinesSampler where values
+// arrive already typed by encoding/json instead of as raw CSV cell strings.
+func (state *columnTypeState) observeValue(value interface{}) {
+	if value == nil {
+		state.nullable = true
+		return
+	}
+	var valueType schema.PrimitiveType
+	switch value.(type) {
+	case bool:
+		valueType = schema.BoolType
+	case float64:
+		valueType = schema.Float64Type
+	default:
+		valueType = schema.StringType
+	}
+	if !state.seenValue {
+		state.inferredType = valueType
+	} else {
+		state.inferredType = promote(state.inferredType, valueType)
+	}
+	state.seenValue = true
+}
+
+// promote returns the narrowest PrimitiveType that can represent both a and b. Int64Type and
+// Float64Type are the only pair considered compatible (a float column tolerates integer-looking
+// cells); any other mismatch means the column isn't uniformly typed, so it falls back to
+// StringType rather than picking one of the two types arbitrarily.
+func promote(a, b schema.PrimitiveType) schema.PrimitiveType {
+	if a == b {
+		return a
+	}
+	if isNumericType(a) && isNumericType(b) {
+		return schema.Float64Type
+	}
+	return schema.StringType
+}
+
+func isNumericType(t schema.PrimitiveType) bool {
+	return t == schema.Int64Type || t == schema.Float64Type
+}
+
+func isNullSentinel(value string, sentinels []string) bool {
+	for _, sentinel := range sentinels {
+		if value == sentinel {
+			return true
+		}
+	}
+	return false
+}
+
+// isBoolLiteral reports whether value is "true"/"false" (case-insensitive), unlike the
+// "0"/"1"/"t"/"f" shorthands strconv.ParseBool also accepts and which collide with int cells.
+func isBoolLiteral(value string) bool {
+	switch strings.ToLower(value) {
+	case "true", "false":
+		return true
+	default:
+		return false
+	}
+}
+
+// inferCellType returns the most specific PrimitiveType that value parses as, falling back to
+// schema.StringType if it matches none of the narrower types.
+func inferCellType(value string) schema.PrimitiveType {
+	if _, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return schema.Int64Type
+	}
+	if _, err := strconv.ParseFloat(value, 64); err == nil {
+		return schema.Float64Type
+	}
+	if isBoolLiteral(value) {
+		return schema.BoolType
+	}
+	for _, layout := range timestampLayouts {
+		if _, err := time.Parse(layout, value); err == nil {
+			return schema.TimestampType
+		}
+	}
+	return schema.StringType
+}
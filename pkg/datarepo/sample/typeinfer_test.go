@@ -0,0 +1,78 @@
+package sample
+
+import (
+	"testing"
+
+	"github.com/Eventual-Inc/Daft/pkg/datarepo/schema"
+)
+
+func TestInferCellType(t *testing.T) {
+	tests := []struct {
+		value string
+		want  schema.PrimitiveType
+	}{
+		{"123", schema.Int64Type},
+		{"-4.5", schema.Float64Type},
+		{"true", schema.BoolType},
+		{"FALSE", schema.BoolType},
+		// "1"/"0" look boolean to strconv.ParseBool, but are far more often integers; they must
+		// infer as Int64Type, not BoolType.
+		{"1", schema.Int64Type},
+		{"0", schema.Int64Type},
+		{"2006-01-02", schema.TimestampType},
+		{"hello", schema.StringType},
+	}
+	for _, tc := range tests {
+		if got := inferCellType(tc.value); got != tc.want {
+			t.Errorf("inferCellType(%q) = %v, want %v", tc.value, got, tc.want)
+		}
+	}
+}
+
+// TestColumnTypeStateDoesNotPromoteNumericColumnToBool reproduces the scenario that used to
+// promote a numeric column to BoolType: a "0"/"1"-only prefix followed by a wider integer. The
+// column must stay Int64Type throughout, since isBoolLiteral only matches "true"/"false".
+func TestColumnTypeStateDoesNotPromoteNumericColumnToBool(t *testing.T) {
+	state := columnTypeState{inferredType: schema.StringType}
+	for _, cell := range []string{"0", "1", "2"} {
+		state.observe(cell, defaultNullSentinels)
+	}
+	if state.inferredType != schema.Int64Type {
+		t.Fatalf("inferredType = %v, want %v", state.inferredType, schema.Int64Type)
+	}
+}
+
+func TestColumnTypeStatePromotesIntToFloat(t *testing.T) {
+	state := columnTypeState{inferredType: schema.StringType}
+	for _, cell := range []string{"1", "2.5"} {
+		state.observe(cell, defaultNullSentinels)
+	}
+	if state.inferredType != schema.Float64Type {
+		t.Fatalf("inferredType = %v, want %v", state.inferredType, schema.Float64Type)
+	}
+}
+
+// TestColumnTypeStateFallsBackToStringOnDomainConflict covers a column that looks numeric until
+// a literal "true" shows up: int/float and bool are incompatible domains, so the column must
+// fall back to StringType rather than being promoted to BoolType just because BoolType used to
+// rank higher in the old lattice.
+func TestColumnTypeStateFallsBackToStringOnDomainConflict(t *testing.T) {
+	state := columnTypeState{inferredType: schema.StringType}
+	for _, cell := range []string{"1", "2", "true"} {
+		state.observe(cell, defaultNullSentinels)
+	}
+	if state.inferredType != schema.StringType {
+		t.Fatalf("inferredType = %v, want %v", state.inferredType, schema.StringType)
+	}
+}
+
+func TestColumnTypeStateObserveNullSentinel(t *testing.T) {
+	state := columnTypeState{inferredType: schema.StringType}
+	state.observe("NULL", defaultNullSentinels)
+	if !state.nullable {
+		t.Fatal("expected nullable to be set after observing a null sentinel")
+	}
+	if state.seenValue {
+		t.Fatal("a null sentinel should not count as a seen value")
+	}
+}
@@ -0,0 +1,30 @@
+package schema
+
+// PrimitiveType is the type of a single column in a Schema.
+type PrimitiveType string
+
+const (
+	StringType    PrimitiveType = "string"
+	Int64Type     PrimitiveType = "int64"
+	Float64Type   PrimitiveType = "float64"
+	BoolType      PrimitiveType = "bool"
+	TimestampType PrimitiveType = "timestamp"
+)
+
+// SchemaField describes a single column.
+type SchemaField struct {
+	Name     string
+	Comment  string
+	Type     PrimitiveType
+	Nullable bool
+}
+
+// NewPrimitiveField builds a SchemaField of a primitive type, defaulting to non-nullable.
+func NewPrimitiveField(name string, comment string, fieldType PrimitiveType) SchemaField {
+	return SchemaField{Name: name, Comment: comment, Type: fieldType}
+}
+
+// Schema is an ordered list of columns.
+type Schema struct {
+	Fields []SchemaField
+}
@@ -0,0 +1,81 @@
+package objectstorage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
+)
+
+// AzureBlobObjectStore implements ObjectStore against a single Azure Blob Storage container.
+type AzureBlobObjectStore struct {
+	client    *azblob.Client
+	container string
+}
+
+func NewAzureBlobObjectStore(account string, container string) (*AzureBlobObjectStore, error) {
+	cred, err := azidentity.NewDefaultAzureCredential(nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load Azure credentials: %w", err)
+	}
+	serviceURL := fmt.Sprintf("https://%s.blob.core.windows.net/", account)
+	client, err := azblob.NewClient(serviceURL, cred, nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create Azure Blob client for account %s: %w", account, err)
+	}
+	return &AzureBlobObjectStore{client: client, container: container}, nil
+}
+
+func (store *AzureBlobObjectStore) ListObjects(ctx context.Context, dirPath string) ([]string, error) {
+	prefix := strings.TrimPrefix(dirPath, fmt.Sprintf("az://%s/", store.container))
+	var objectPaths []string
+	pager := store.client.NewListBlobsFlatPager(store.container, &azblob.ListBlobsFlatOptions{
+		Prefix: &prefix,
+	})
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("unable to list objects under %s: %w", dirPath, err)
+		}
+		for _, blob := range page.Segment.BlobItems {
+			objectPaths = append(objectPaths, *blob.Name)
+		}
+	}
+	return objectPaths, nil
+}
+
+func (store *AzureBlobObjectStore) DownloadObject(ctx context.Context, objPath string, opts ...DownloadObjectOption) (io.Reader, error) {
+	var downloadOptions DownloadObjectOptions
+	for _, opt := range opts {
+		opt(&downloadOptions)
+	}
+
+	var azOpts azblob.DownloadStreamOptions
+	if downloadOptions.HasRange {
+		azOpts.Range = azblob.HTTPRange{
+			Offset: downloadOptions.RangeStart,
+			Count:  downloadOptions.RangeLength,
+		}
+	}
+
+	resp, err := store.client.DownloadStream(ctx, store.container, objPath, &azOpts)
+	if err != nil {
+		return nil, fmt.Errorf("unable to download %s/%s: %w", store.container, objPath, err)
+	}
+	return resp.Body, nil
+}
+
+func (store *AzureBlobObjectStore) ObjectSize(ctx context.Context, objPath string) (int64, error) {
+	blobClient := store.client.ServiceClient().NewContainerClient(store.container).NewBlobClient(objPath)
+	props, err := blobClient.GetProperties(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("unable to stat %s/%s: %w", store.container, objPath, err)
+	}
+	if props.ContentLength == nil {
+		return 0, fmt.Errorf("unable to stat %s/%s: missing content length", store.container, objPath)
+	}
+	return *props.ContentLength, nil
+}
@@ -0,0 +1,71 @@
+package objectstorage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"cloud.google.com/go/storage"
+	"google.golang.org/api/iterator"
+)
+
+// GCSObjectStore implements ObjectStore against a single Google Cloud Storage bucket.
+type GCSObjectStore struct {
+	client *storage.Client
+	bucket string
+}
+
+func NewGCSObjectStore(ctx context.Context, bucket string) (*GCSObjectStore, error) {
+	client, err := storage.NewClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create GCS client: %w", err)
+	}
+	return &GCSObjectStore{client: client, bucket: bucket}, nil
+}
+
+func (store *GCSObjectStore) ListObjects(ctx context.Context, dirPath string) ([]string, error) {
+	prefix := strings.TrimPrefix(dirPath, fmt.Sprintf("gs://%s/", store.bucket))
+	var objectPaths []string
+	it := store.client.Bucket(store.bucket).Objects(ctx, &storage.Query{Prefix: prefix})
+	for {
+		attrs, err := it.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("unable to list objects under %s: %w", dirPath, err)
+		}
+		objectPaths = append(objectPaths, attrs.Name)
+	}
+	return objectPaths, nil
+}
+
+func (store *GCSObjectStore) DownloadObject(ctx context.Context, objPath string, opts ...DownloadObjectOption) (io.Reader, error) {
+	var downloadOptions DownloadObjectOptions
+	for _, opt := range opts {
+		opt(&downloadOptions)
+	}
+
+	object := store.client.Bucket(store.bucket).Object(objPath)
+	if downloadOptions.HasRange {
+		reader, err := object.NewRangeReader(ctx, downloadOptions.RangeStart, downloadOptions.RangeLength)
+		if err != nil {
+			return nil, fmt.Errorf("unable to download gs://%s/%s: %w", store.bucket, objPath, err)
+		}
+		return reader, nil
+	}
+	reader, err := object.NewReader(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to download gs://%s/%s: %w", store.bucket, objPath, err)
+	}
+	return reader, nil
+}
+
+func (store *GCSObjectStore) ObjectSize(ctx context.Context, objPath string) (int64, error) {
+	attrs, err := store.client.Bucket(store.bucket).Object(objPath).Attrs(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("unable to stat gs://%s/%s: %w", store.bucket, objPath, err)
+	}
+	return attrs.Size, nil
+}
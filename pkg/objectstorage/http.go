@@ -0,0 +1,67 @@
+package objectstorage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// HTTPObjectStore implements ObjectStore against a single object reachable over plain HTTP(S),
+// using Range requests for WithDownloadRange. A bare HTTP(S) endpoint exposes no directory
+// listing API, so ListObjects just returns dirPath itself, treating it as a "directory" of one.
+type HTTPObjectStore struct {
+	client *http.Client
+}
+
+func NewHTTPObjectStore() *HTTPObjectStore {
+	return &HTTPObjectStore{client: http.DefaultClient}
+}
+
+func (store *HTTPObjectStore) ListObjects(ctx context.Context, dirPath string) ([]string, error) {
+	return []string{dirPath}, nil
+}
+
+func (store *HTTPObjectStore) DownloadObject(ctx context.Context, objPath string, opts ...DownloadObjectOption) (io.Reader, error) {
+	var downloadOptions DownloadObjectOptions
+	for _, opt := range opts {
+		opt(&downloadOptions)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, objPath, nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to build request for %s: %w", objPath, err)
+	}
+	if downloadOptions.HasRange {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-%d", downloadOptions.RangeStart, downloadOptions.RangeStart+downloadOptions.RangeLength-1))
+	}
+
+	resp, err := store.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to download %s: %w", objPath, err)
+	}
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusPartialContent {
+		resp.Body.Close()
+		return nil, fmt.Errorf("unable to download %s: unexpected status %s", objPath, resp.Status)
+	}
+	return resp.Body, nil
+}
+
+func (store *HTTPObjectStore) ObjectSize(ctx context.Context, objPath string) (int64, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, objPath, nil)
+	if err != nil {
+		return 0, fmt.Errorf("unable to build request for %s: %w", objPath, err)
+	}
+	resp, err := store.client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("unable to stat %s: %w", objPath, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("unable to stat %s: unexpected status %s", objPath, resp.Status)
+	}
+	if resp.ContentLength < 0 {
+		return 0, fmt.Errorf("unable to stat %s: server did not report a content length", objPath)
+	}
+	return resp.ContentLength, nil
+}
@@ -0,0 +1,83 @@
+package objectstorage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// LocalFSObjectStore implements ObjectStore against a directory on the local filesystem, rooted
+// at root so object paths behave the same way relative paths under a bucket prefix would.
+type LocalFSObjectStore struct {
+	root string
+}
+
+func NewLocalFSObjectStore(root string) *LocalFSObjectStore {
+	return &LocalFSObjectStore{root: root}
+}
+
+func (store *LocalFSObjectStore) resolve(objPath string) string {
+	return filepath.Join(store.root, objPath)
+}
+
+func (store *LocalFSObjectStore) ListObjects(ctx context.Context, dirPath string) ([]string, error) {
+	dirPath = strings.TrimPrefix(dirPath, "file://")
+	var objectPaths []string
+	err := filepath.Walk(dirPath, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		rel, err := filepath.Rel(store.root, path)
+		if err != nil {
+			return err
+		}
+		objectPaths = append(objectPaths, rel)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to list objects under %s: %w", dirPath, err)
+	}
+	return objectPaths, nil
+}
+
+func (store *LocalFSObjectStore) DownloadObject(ctx context.Context, objPath string, opts ...DownloadObjectOption) (io.Reader, error) {
+	var downloadOptions DownloadObjectOptions
+	for _, opt := range opts {
+		opt(&downloadOptions)
+	}
+
+	f, err := os.Open(store.resolve(objPath))
+	if err != nil {
+		return nil, fmt.Errorf("unable to open %s: %w", objPath, err)
+	}
+	if downloadOptions.HasRange {
+		return &sectionReadCloser{
+			SectionReader: io.NewSectionReader(f, downloadOptions.RangeStart, downloadOptions.RangeLength),
+			f:             f,
+		}, nil
+	}
+	return f, nil
+}
+
+// sectionReadCloser adapts an io.SectionReader over an *os.File so callers that range-download
+// still get a Closer that releases the underlying file handle.
+type sectionReadCloser struct {
+	*io.SectionReader
+	f *os.File
+}
+
+func (r *sectionReadCloser) Close() error { return r.f.Close() }
+
+func (store *LocalFSObjectStore) ObjectSize(ctx context.Context, objPath string) (int64, error) {
+	info, err := os.Stat(store.resolve(objPath))
+	if err != nil {
+		return 0, fmt.Errorf("unable to stat %s: %w", objPath, err)
+	}
+	return info.Size(), nil
+}
@@ -0,0 +1,44 @@
+// Package objectstorage provides a single ObjectStore abstraction over the handful of backends
+// Daft datasets can live in - S3, GCS, Azure Blob, the local filesystem, and plain HTTP(S)
+// range-GET endpoints - so that callers like sample.CSVSampler never need to know which backend
+// a given dataset's manifest points at.
+package objectstorage
+
+import (
+	"context"
+	"io"
+)
+
+// ObjectStore lists and downloads objects, optionally by byte range, from a single backend.
+type ObjectStore interface {
+	// ListObjects returns every object path under dirPath.
+	ListObjects(ctx context.Context, dirPath string) ([]string, error)
+
+	// DownloadObject returns a reader over objPath's bytes, or over just the range requested via
+	// WithDownloadRange if one was given. The returned reader additionally implements io.Closer
+	// when the backend holds an underlying connection/handle that should be released once the
+	// caller is done reading.
+	DownloadObject(ctx context.Context, objPath string, opts ...DownloadObjectOption) (io.Reader, error)
+
+	// ObjectSize returns objPath's total size in bytes, used by callers (e.g. ParquetSampler)
+	// that need to seek relative to the end of the object.
+	ObjectSize(ctx context.Context, objPath string) (int64, error)
+}
+
+// DownloadObjectOptions holds the parsed result of DownloadObjectOption functional options.
+type DownloadObjectOptions struct {
+	HasRange    bool
+	RangeStart  int64
+	RangeLength int64
+}
+
+type DownloadObjectOption = func(*DownloadObjectOptions)
+
+// WithDownloadRange restricts a DownloadObject call to the `length` bytes starting at `start`.
+func WithDownloadRange(start int64, length int64) DownloadObjectOption {
+	return func(opt *DownloadObjectOptions) {
+		opt.HasRange = true
+		opt.RangeStart = start
+		opt.RangeLength = length
+	}
+}
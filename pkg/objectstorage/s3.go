@@ -0,0 +1,77 @@
+package objectstorage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+)
+
+// S3ObjectStore implements ObjectStore against a single AWS S3 bucket.
+type S3ObjectStore struct {
+	client *s3.Client
+	bucket string
+}
+
+func NewS3ObjectStore(ctx context.Context, bucket string) (*S3ObjectStore, error) {
+	cfg, err := config.LoadDefaultConfig(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load AWS config: %w", err)
+	}
+	return &S3ObjectStore{client: s3.NewFromConfig(cfg), bucket: bucket}, nil
+}
+
+func (store *S3ObjectStore) ListObjects(ctx context.Context, dirPath string) ([]string, error) {
+	prefix := strings.TrimPrefix(dirPath, fmt.Sprintf("s3://%s/", store.bucket))
+	var objectPaths []string
+	paginator := s3.NewListObjectsV2Paginator(store.client, &s3.ListObjectsV2Input{
+		Bucket: aws.String(store.bucket),
+		Prefix: aws.String(prefix),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("unable to list objects under %s: %w", dirPath, err)
+		}
+		for _, obj := range page.Contents {
+			objectPaths = append(objectPaths, aws.ToString(obj.Key))
+		}
+	}
+	return objectPaths, nil
+}
+
+func (store *S3ObjectStore) DownloadObject(ctx context.Context, objPath string, opts ...DownloadObjectOption) (io.Reader, error) {
+	var downloadOptions DownloadObjectOptions
+	for _, opt := range opts {
+		opt(&downloadOptions)
+	}
+
+	input := &s3.GetObjectInput{
+		Bucket: aws.String(store.bucket),
+		Key:    aws.String(objPath),
+	}
+	if downloadOptions.HasRange {
+		input.Range = aws.String(fmt.Sprintf("bytes=%d-%d", downloadOptions.RangeStart, downloadOptions.RangeStart+downloadOptions.RangeLength-1))
+	}
+
+	output, err := store.client.GetObject(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("unable to download s3://%s/%s: %w", store.bucket, objPath, err)
+	}
+	return output.Body, nil
+}
+
+func (store *S3ObjectStore) ObjectSize(ctx context.Context, objPath string) (int64, error) {
+	output, err := store.client.HeadObject(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(store.bucket),
+		Key:    aws.String(objPath),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("unable to stat s3://%s/%s: %w", store.bucket, objPath, err)
+	}
+	return aws.ToInt64(output.ContentLength), nil
+}